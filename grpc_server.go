@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sparkes/fixphrase-service/internal/apierr"
+	"github.com/sparkes/fixphrase-service/internal/fixphrasepb"
+)
+
+// fixPhraseGRPCServer adapts the existing encodeFixPhrase/decodeFixPhrase
+// logic to the generated FixPhraseServer interface.
+type fixPhraseGRPCServer struct {
+	fixphrasepb.UnimplementedFixPhraseServer
+	svc *Service
+}
+
+func newFixPhraseGRPCServer(svc *Service) *fixPhraseGRPCServer {
+	return &fixPhraseGRPCServer{svc: svc}
+}
+
+// grpcCodeFor maps an apierr catalog code to the gRPC status code that best
+// describes it: request-cancellation and out-of-range word indices aren't
+// "the client sent bad input" in the way the rest of the catalog is.
+func grpcCodeFor(code int) codes.Code {
+	switch code {
+	case apierr.CodeRequestCanceled:
+		return codes.Canceled
+	case apierr.CodeWordIndexOutOfRange:
+		return codes.Internal
+	default:
+		return codes.InvalidArgument
+	}
+}
+
+// toAPIErrorProto converts an apierr.Error into the wire type shared by the
+// unary status detail and the per-item stream error field, so both surfaces
+// carry the same stable numeric Code HTTP clients get.
+func toAPIErrorProto(apiErr *apierr.Error) *fixphrasepb.ApiError {
+	return &fixphrasepb.ApiError{Code: int32(apiErr.Code), Message: apiErr.Message}
+}
+
+// grpcStatusErr maps apiErr to a gRPC status carrying Code/Message as a
+// detail, so non-HTTP callers can branch on the same catalog code instead of
+// string-matching the flattened message gRPC would otherwise surface.
+func grpcStatusErr(apiErr *apierr.Error) error {
+	code := grpcCodeFor(apiErr.Code)
+	st, detailErr := status.New(code, apiErr.Message).WithDetails(toAPIErrorProto(apiErr))
+	if detailErr != nil {
+		return status.Error(code, apiErr.Message)
+	}
+	return st.Err()
+}
+
+// toCorrectionProtos converts the algorithm-level Correction slice to its
+// proto equivalent.
+func toCorrectionProtos(corrections []Correction) []*fixphrasepb.Correction {
+	if len(corrections) == 0 {
+		return nil
+	}
+	out := make([]*fixphrasepb.Correction, len(corrections))
+	for i, c := range corrections {
+		out[i] = &fixphrasepb.Correction{Original: c.Original, Corrected: c.Corrected}
+	}
+	return out
+}
+
+// encode runs encodeFixPhrase and returns the algorithm error as a typed
+// *apierr.Error (rather than a gRPC status) so both the unary and streaming
+// RPCs can decide for themselves how to surface it.
+func (s *fixPhraseGRPCServer) encode(ctx context.Context, req *fixphrasepb.EncodeRequest) (*fixphrasepb.EncodeResponse, *apierr.Error) {
+	res, err := encodeFixPhrase(ctx, s.svc, req.GetLat(), req.GetLon())
+	if err != nil {
+		apiErr, ok := apierr.As(err)
+		if !ok {
+			apiErr = apierr.New(apierr.CodeInvalidRequest, err)
+		}
+		return nil, apiErr
+	}
+	return &fixphrasepb.EncodeResponse{
+		Lat:     res.Lat,
+		Lon:     res.Lon,
+		Phrase:  res.Phrase,
+		Words:   res.Words,
+		Clamped: res.Clamped,
+	}, nil
+}
+
+// decode is the Decode counterpart of encode.
+func (s *fixPhraseGRPCServer) decode(ctx context.Context, req *fixphrasepb.DecodeRequest) (*fixphrasepb.DecodeResponse, *apierr.Error) {
+	res, err := decodeFixPhrase(ctx, s.svc, req.GetPhrase())
+	if err != nil {
+		apiErr, ok := apierr.As(err)
+		if !ok {
+			apiErr = apierr.New(apierr.CodeInvalidRequest, err)
+		}
+		return nil, apiErr
+	}
+	return &fixphrasepb.DecodeResponse{
+		InputWords:      res.InputWords,
+		CanonicalPhrase: res.CanonicalPhrase,
+		Lat:             res.Lat,
+		Lon:             res.Lon,
+		AccuracyDegrees: res.AccuracyDegrees,
+		Corrections:     toCorrectionProtos(res.Corrections),
+	}, nil
+}
+
+func (s *fixPhraseGRPCServer) Encode(ctx context.Context, req *fixphrasepb.EncodeRequest) (*fixphrasepb.EncodeResponse, error) {
+	res, apiErr := s.encode(ctx, req)
+	if apiErr != nil {
+		return nil, grpcStatusErr(apiErr)
+	}
+	return res, nil
+}
+
+func (s *fixPhraseGRPCServer) Decode(ctx context.Context, req *fixphrasepb.DecodeRequest) (*fixphrasepb.DecodeResponse, error) {
+	res, apiErr := s.decode(ctx, req)
+	if apiErr != nil {
+		return nil, grpcStatusErr(apiErr)
+	}
+	return res, nil
+}
+
+func (s *fixPhraseGRPCServer) EncodeStream(stream fixphrasepb.FixPhrase_EncodeStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		// A bad item shouldn't kill a long-lived stream: report it inline
+		// and keep going, the same way the batch HTTP endpoints never fail
+		// the whole batch on one bad input.
+		res, apiErr := s.encode(stream.Context(), req)
+		if apiErr != nil {
+			res = &fixphrasepb.EncodeResponse{Error: toAPIErrorProto(apiErr)}
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *fixPhraseGRPCServer) DecodeStream(stream fixphrasepb.FixPhrase_DecodeStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		// A bad item shouldn't kill a long-lived stream: report it inline
+		// and keep going, the same way the batch HTTP endpoints never fail
+		// the whole batch on one bad input.
+		res, apiErr := s.decode(stream.Context(), req)
+		if apiErr != nil {
+			res = &fixphrasepb.DecodeResponse{Error: toAPIErrorProto(apiErr)}
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/sparkes/fixphrase-service/internal/apierr"
+	"github.com/sparkes/fixphrase-service/internal/fixphrasepb"
+)
+
+// dialTestGRPCServer spins up the FixPhrase gRPC service over an in-memory
+// bufconn listener and returns a connected client, so these tests exercise
+// real marshal/unmarshal and status-detail plumbing rather than calling the
+// server methods directly.
+func dialTestGRPCServer(t *testing.T) fixphrasepb.FixPhraseClient {
+	t.Helper()
+	svc := newTestService(t)
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	fixphrasepb.RegisterFixPhraseServer(srv, newFixPhraseGRPCServer(svc))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.DialContext: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return fixphrasepb.NewFixPhraseClient(conn)
+}
+
+// TestGRPCEncodeErrorCarriesAPIErrorCode guards against apierr.Code being
+// flattened into an opaque gRPC status message: a client should be able to
+// recover the same numeric code the HTTP API returns.
+func TestGRPCEncodeErrorCarriesAPIErrorCode(t *testing.T) {
+	client := dialTestGRPCServer(t)
+	ctx := context.Background()
+
+	_, err := client.Encode(ctx, &fixphrasepb.EncodeRequest{Lat: 999, Lon: 0})
+	if err == nil {
+		t.Fatal("Encode(lat=999) = nil error, want an error")
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("status.FromError(%v) = not ok", err)
+	}
+	var found *fixphrasepb.ApiError
+	for _, d := range st.Details() {
+		// Details() unmarshals the Any via the v2 registry, which hands back
+		// a dynamic wrapper around our legacy-style message rather than the
+		// concrete type directly; unwrap it the same way a real client
+		// using this generated code would.
+		if apiErr, ok := proto.MessageV1(d).(*fixphrasepb.ApiError); ok {
+			found = apiErr
+		}
+	}
+	if found == nil {
+		t.Fatalf("status details = %v, want an ApiError detail", st.Details())
+	}
+	if found.Code != apierr.CodeInvalidLat {
+		t.Errorf("ApiError.Code = %d, want %d (CodeInvalidLat)", found.Code, apierr.CodeInvalidLat)
+	}
+}
+
+// TestGRPCEncodeStreamSurvivesBadItem guards against a single out-of-range
+// item killing the whole EncodeStream RPC: the stream must stay open and
+// report the bad item inline so later valid items still get encoded.
+func TestGRPCEncodeStreamSurvivesBadItem(t *testing.T) {
+	client := dialTestGRPCServer(t)
+	ctx := context.Background()
+
+	stream, err := client.EncodeStream(ctx)
+	if err != nil {
+		t.Fatalf("EncodeStream: %v", err)
+	}
+
+	reqs := []*fixphrasepb.EncodeRequest{
+		{Lat: 10, Lon: 20},
+		{Lat: 999, Lon: 0}, // out of range
+		{Lat: -5, Lon: -5},
+	}
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("Send(%v): %v", req, err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend: %v", err)
+	}
+
+	var got []*fixphrasepb.EncodeResponse
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		got = append(got, res)
+	}
+	if len(got) != len(reqs) {
+		t.Fatalf("got %d responses, want %d (one per request, including the bad one)", len(got), len(reqs))
+	}
+	if got[0].GetError() != nil || got[0].GetPhrase() == "" {
+		t.Errorf("response[0] = %+v, want a successful encode", got[0])
+	}
+	if got[1].GetError() == nil {
+		t.Errorf("response[1] = %+v, want an inline error for the out-of-range item", got[1])
+	} else if got[1].GetError().Code != apierr.CodeInvalidLat {
+		t.Errorf("response[1].Error.Code = %d, want %d", got[1].GetError().Code, apierr.CodeInvalidLat)
+	}
+	if got[2].GetError() != nil || got[2].GetPhrase() == "" {
+		t.Errorf("response[2] = %+v, want a successful encode (stream must survive item 1's error)", got[2])
+	}
+}
+
+// TestGRPCEncodeCarriesClamped guards against Clamped being silently dropped
+// when encodeFixPhrase's result crosses from the HTTP DTO into the gRPC
+// response, as it was before this field existed on EncodeResponse.
+func TestGRPCEncodeCarriesClamped(t *testing.T) {
+	client := dialTestGRPCServer(t)
+	ctx := context.Background()
+
+	res, err := client.Encode(ctx, &fixphrasepb.EncodeRequest{Lat: 90.0005, Lon: 0})
+	if err != nil {
+		t.Fatalf("Encode(lat=90.0005): %v", err)
+	}
+	if !res.GetClamped() {
+		t.Errorf("Encode(lat=90.0005).Clamped = false, want true")
+	}
+}
+
+// TestGRPCDecodeCarriesCorrections guards against Corrections being silently
+// dropped when decodeFixPhrase's result crosses into the gRPC response, as it
+// was before this field existed on DecodeResponse.
+func TestGRPCDecodeCarriesCorrections(t *testing.T) {
+	client := dialTestGRPCServer(t)
+	ctx := context.Background()
+
+	enc, err := client.Encode(ctx, &fixphrasepb.EncodeRequest{Lat: 10, Lon: 20})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	words := enc.GetWords()
+	if len(words) == 0 {
+		t.Fatalf("Encode returned no words")
+	}
+	last := words[len(words)-1]
+	words[len(words)-1] = last + "x"
+	phrase := strings.Join(words, " ")
+
+	res, err := client.Decode(ctx, &fixphrasepb.DecodeRequest{Phrase: phrase})
+	if err != nil {
+		t.Fatalf("Decode(%q): %v", phrase, err)
+	}
+	if len(res.GetCorrections()) != 1 {
+		t.Fatalf("Decode(%q).Corrections = %v, want exactly one correction", phrase, res.GetCorrections())
+	}
+	if got := res.GetCorrections()[0].GetCorrected(); got != last {
+		t.Errorf("Corrections[0].Corrected = %q, want %q", got, last)
+	}
+}
@@ -0,0 +1,95 @@
+// Package apierr defines the service's structured error type and its
+// catalog of stable numeric codes, so callers can branch on Code instead of
+// string-matching English error messages.
+package apierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error is a structured API error. Message is documented once per Code in
+// the catalog; Cause (if set) carries the underlying Go error for logs but
+// is never serialized to callers. Usage is set only for request-shape
+// errors (e.g. a missing query param) that benefit from showing the caller
+// how the endpoint is meant to be called.
+type Error struct {
+	Code    int      `json:"code"`
+	Message string   `json:"message"`
+	Usage   []string `json:"usage,omitempty"`
+	Cause   error    `json:"-"`
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error { return e.Cause }
+
+// New builds an *Error for a catalog code, optionally wrapping cause for
+// logging. Passing an unregistered code is a programmer error and panics,
+// the same way an out-of-range slice index would.
+func New(code int, cause error) *Error {
+	msg, ok := catalog[code]
+	if !ok {
+		panic(fmt.Sprintf("apierr: unregistered code %d", code))
+	}
+	return &Error{Code: code, Message: msg, Cause: cause}
+}
+
+// NewWithUsage is New plus a Usage hint, for request-shape errors where the
+// caller is missing a required field and would benefit from an example of
+// how to call the endpoint correctly.
+func NewWithUsage(code int, cause error, usage []string) *Error {
+	e := New(code, cause)
+	e.Usage = usage
+	return e
+}
+
+// As reports whether err is (or wraps) an *Error, returning it if so.
+func As(err error) (*Error, bool) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return nil, false
+	}
+	return apiErr, true
+}
+
+// Catalog codes. 1xxx is the encode/HTTP-input family, 2xxx is the
+// decode/phrase family. Add new codes here rather than constructing
+// ad-hoc Error values elsewhere, so the catalog stays the single source of
+// truth for what a client can expect to see on the wire.
+const (
+	CodeInvalidRequest      = 1000 // malformed or missing request fields
+	CodeInvalidLat          = 1001 // latitude out of the [-90, 90] range
+	CodeInvalidLon          = 1002 // longitude out of the [-180, 180] range
+	CodeInvalidJSON         = 1003 // request body failed to decode as JSON
+	CodeMethodNotAllowed    = 1004 // unsupported HTTP method for the route
+	CodeBatchTooLarge       = 1005 // batch item count exceeded MaxBatch
+	CodeWordIndexOutOfRange = 1006 // computed grid index has no matching word
+	CodeRequestCanceled     = 1007 // request context was canceled or timed out
+
+	CodePhraseUndecodable = 2001 // phrase lacks a decodable first-range (latitude) or second-range (longitude) word
+	CodeUnknownWord       = 2002 // one or more input words had no exact or fuzzy match
+	CodePhraseEmpty       = 2003 // phrase was empty after trimming
+	CodePhraseTooShort    = 2004 // phrase had fewer than two words
+)
+
+var catalog = map[int]string{
+	CodeInvalidRequest:      "invalid_request",
+	CodeInvalidLat:          "invalid_lat",
+	CodeInvalidLon:          "invalid_lon",
+	CodeInvalidJSON:         "invalid_json",
+	CodeMethodNotAllowed:    "method_not_allowed",
+	CodeBatchTooLarge:       "batch_too_large",
+	CodeWordIndexOutOfRange: "word_index_out_of_range",
+	CodeRequestCanceled:     "request_canceled",
+
+	CodePhraseUndecodable: "phrase_undecodable",
+	CodeUnknownWord:       "unknown_word",
+	CodePhraseEmpty:       "phrase_empty",
+	CodePhraseTooShort:    "phrase_too_short",
+}
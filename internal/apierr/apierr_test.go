@@ -0,0 +1,50 @@
+package apierr
+
+import "testing"
+
+func TestNewPanicsOnUnregisteredCode(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("New did not panic on an unregistered code")
+		}
+	}()
+	New(99999, nil)
+}
+
+func TestCatalogCoversEveryCode(t *testing.T) {
+	codes := []int{
+		CodeInvalidRequest, CodeInvalidLat, CodeInvalidLon, CodeInvalidJSON,
+		CodeMethodNotAllowed, CodeBatchTooLarge, CodeWordIndexOutOfRange, CodeRequestCanceled,
+		CodePhraseUndecodable, CodeUnknownWord, CodePhraseEmpty, CodePhraseTooShort,
+	}
+	for _, code := range codes {
+		if msg, ok := catalog[code]; !ok || msg == "" {
+			t.Errorf("code %d has no catalog message", code)
+		}
+	}
+}
+
+func TestNewWithUsageSetsUsageAndOmitsItByDefault(t *testing.T) {
+	plain := New(CodeInvalidRequest, nil)
+	if len(plain.Usage) != 0 {
+		t.Fatalf("New() set Usage = %v, want empty", plain.Usage)
+	}
+
+	withUsage := NewWithUsage(CodeInvalidRequest, nil, []string{"GET /encode?lat=..&lon=.."})
+	if len(withUsage.Usage) != 1 || withUsage.Usage[0] != "GET /encode?lat=..&lon=.." {
+		t.Fatalf("NewWithUsage() Usage = %v, want one usage line", withUsage.Usage)
+	}
+	if withUsage.Code != CodeInvalidRequest || withUsage.Message != catalog[CodeInvalidRequest] {
+		t.Fatalf("NewWithUsage() = %+v, want Code/Message from New()", withUsage)
+	}
+}
+
+func TestAs(t *testing.T) {
+	apiErr := New(CodePhraseEmpty, nil)
+	if got, ok := As(apiErr); !ok || got != apiErr {
+		t.Fatalf("As(*Error) = %v, %v, want %v, true", got, ok, apiErr)
+	}
+	if _, ok := As(nil); ok {
+		t.Fatal("As(nil) = true, want false")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -16,7 +17,13 @@ import (
 	"strings"
 	"syscall"
 	"time"
+
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+
+	"github.com/sparkes/fixphrase-service/internal/apierr"
+	"github.com/sparkes/fixphrase-service/internal/fixphrasepb"
 )
 
 // ---- version stamping (set via -ldflags) ----
@@ -27,18 +34,39 @@ var (
 )
 
 type Config struct {
-	Addr       string
-	ServerName string
-	RepoURL    string
-	GHCRImage  string
+	Addr           string
+	GRPCAddr       string
+	ServerName     string
+	RepoURL        string
+	GHCRImage      string
+	MaxBatch       int
+	RequestTimeout time.Duration
+	ClampEpsilon   float64
 }
 
 //go:embed wordlist/wordlist.json
 var embeddedFS embed.FS
 
 type Service struct {
-	words    []string
-	wordToIndex map[string]int
+	words        []string
+	wordToIndex  map[string]int
+	fuzzyIndex   map[fuzzyKey][]string
+	clampEpsilon float64
+}
+
+// fuzzyKey buckets words by length so a fuzzy lookup only has to score
+// candidates of a plausible length instead of the whole wordlist. Lookups
+// also probe length-1 and length+1 so a single dropped or inserted letter
+// (which shifts length by one) still finds its bucket.
+type fuzzyKey struct {
+	length int
+}
+
+// Correction describes a word that didn't match the wordlist exactly but was
+// close enough to accept via fuzzy matching.
+type Correction struct {
+	Original  string `json:"original"`
+	Corrected string `json:"corrected"`
 }
 // ---------- Algorithm types ----------
 type EncodeResponse struct {
@@ -46,14 +74,16 @@ type EncodeResponse struct {
 	Lon     float64  `json:"lon"`
 	Phrase  string   `json:"phrase"`
 	Words   []string `json:"words"`
+	Clamped bool     `json:"clamped,omitempty"`
 }
 
 type DecodeResponse struct {
-	InputWords      []string `json:"inputWords"`
-	CanonicalPhrase string   `json:"canonicalPhrase"`
-	Lat             float64  `json:"lat"`
-	Lon             float64  `json:"lon"`
-	AccuracyDegrees float64  `json:"accuracyDegrees"`
+	InputWords      []string     `json:"inputWords"`
+	CanonicalPhrase string       `json:"canonicalPhrase"`
+	Lat             float64      `json:"lat"`
+	Lon             float64      `json:"lon"`
+	AccuracyDegrees float64      `json:"accuracyDegrees"`
+	Corrections     []Correction `json:"corrections,omitempty"`
 }
 
 // ---------- HTTP types ----------
@@ -66,6 +96,111 @@ type DecodeRequest struct {
 	Phrase string `json:"phrase"`
 }
 
+// BatchEncodeRequest is the body of POST /encode/batch.
+type BatchEncodeRequest struct {
+	Items []EncodeRequest `json:"items"`
+}
+
+// BatchDecodeRequest is the body of POST /decode/batch.
+type BatchDecodeRequest struct {
+	Items []DecodeRequest `json:"items"`
+}
+
+// BatchEncodeItem is one element of a batch /encode/batch response: either
+// Result or Error is set, never both, so one bad input can't fail the batch.
+type BatchEncodeItem struct {
+	Result *EncodeResponse `json:"result,omitempty"`
+	Error  *apierr.Error   `json:"error,omitempty"`
+}
+
+// BatchDecodeItem is one element of a batch /decode/batch response.
+type BatchDecodeItem struct {
+	Result *DecodeResponse `json:"result,omitempty"`
+	Error  *apierr.Error   `json:"error,omitempty"`
+}
+
+// NeighborItem is one compass direction of a GET /neighbors response: the
+// decoded phrase's coordinates perturbed by the phrase's own accuracy and
+// re-encoded, so callers can probe the FixPhrases bordering a given one.
+type NeighborItem struct {
+	Direction string          `json:"direction"`
+	Result    *EncodeResponse `json:"result,omitempty"`
+	Error     *apierr.Error   `json:"error,omitempty"`
+}
+
+// compassOffsets are the eight directions /neighbors probes around a
+// decoded phrase, as (latSign, lonSign) multipliers on the step size.
+var compassOffsets = []struct {
+	direction string
+	latSign   float64
+	lonSign   float64
+}{
+	{"N", 1, 0},
+	{"NE", 1, 1},
+	{"E", 0, 1},
+	{"SE", -1, 1},
+	{"S", -1, 0},
+	{"SW", -1, -1},
+	{"W", 0, -1},
+	{"NW", 1, -1},
+}
+
+// latIntMax and lonIntMax are the bounds of the integer grid encodeFixPhrase
+// derives g0..g3 from: latInt = round(lat*10000) + 900000 spans [0,
+// latIntMax] for lat in [-90, 90], and lonInt = round(lon*10000) + 1800000
+// spans [0, lonIntMax] for lon in [-180, 180].
+const (
+	latIntMax = 1800000
+	lonIntMax = 3600000
+)
+
+// neighborPhrases decodes phrase, then steps radius*accuracy degrees in each
+// of the eight compass directions from its center and re-encodes, so a
+// caller can enumerate the FixPhrases adjacent to a given one. The step is
+// applied on the same integer lat/lon grid that g0..g3 are derived from:
+// longitude wraps around the antimeridian (lon=180 and lon=-180 are the same
+// meridian), while latitude clamps at the poles (there's no cell north of
+// the north pole to wrap to).
+func neighborPhrases(ctx context.Context, svc *Service, phrase string, radius float64) ([]NeighborItem, error) {
+	dec, err := decodeFixPhrase(ctx, svc, phrase)
+	if err != nil {
+		return nil, err
+	}
+
+	latInt0 := int(math.Round(dec.Lat*10000.0)) + latIntMax/2
+	lonInt0 := int(math.Round(dec.Lon*10000.0)) + lonIntMax/2
+	step := int(math.Round(dec.AccuracyDegrees * 10000.0 * radius))
+
+	items := make([]NeighborItem, len(compassOffsets))
+	for i, off := range compassOffsets {
+		latInt := latInt0 + int(off.latSign)*step
+		lonInt := lonInt0 + int(off.lonSign)*step
+
+		if latInt < 0 {
+			latInt = 0
+		} else if latInt > latIntMax {
+			latInt = latIntMax
+		}
+		lonInt = ((lonInt % lonIntMax) + lonIntMax) % lonIntMax
+
+		lat := (float64(latInt) - latIntMax/2) / 10000.0
+		lon := (float64(lonInt) - lonIntMax/2) / 10000.0
+
+		res, err := encodeFixPhrase(ctx, svc, lat, lon)
+		if err != nil {
+			apiErr, _ := apierr.As(err)
+			items[i] = NeighborItem{Direction: off.direction, Error: apiErr}
+			continue
+		}
+		items[i] = NeighborItem{Direction: off.direction, Result: res}
+	}
+	return items, nil
+}
+
+// maxBatchBodyBytes caps the size of a batch request body regardless of
+// MaxBatch, so a client can't send one enormous item to dodge the item-count limit.
+const maxBatchBodyBytes = 10 << 20 // 10 MiB
+
 // ---------- Service setup ----------
 // loadConfig reads configuration from environment variables, with defaults.
 func loadConfig() Config {
@@ -73,10 +208,14 @@ func loadConfig() Config {
 	_ = godotenv.Load()
 
 	cfg := Config{
-		Addr:       getEnv("ADDR", ":7080"),
-		ServerName: getEnv("SERVER_NAME", "fixphrase"),
-		RepoURL:    getEnv("REPO_URL", ""),
-		GHCRImage:  getEnv("GHCR_IMAGE", ""),
+		Addr:           getEnv("ADDR", ":7080"),
+		GRPCAddr:       getEnv("GRPC_ADDR", ":7090"),
+		ServerName:     getEnv("SERVER_NAME", "fixphrase"),
+		RepoURL:        getEnv("REPO_URL", ""),
+		GHCRImage:      getEnv("GHCR_IMAGE", ""),
+		MaxBatch:       getEnvInt("MAX_BATCH", 1000),
+		RequestTimeout: getEnvDuration("REQUEST_TIMEOUT", 5*time.Second),
+		ClampEpsilon:   getEnvFloat("CLAMP_EPSILON", 0.001),
 	}
 	return cfg
 }
@@ -90,6 +229,47 @@ func getEnv(key, fallback string) string {
 	return v
 }
 
+// getEnvInt reads an environment variable as an int, or a fallback if not set, empty, or invalid.
+func getEnvInt(key string, fallback int) int {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
+
+// getEnvDuration reads an environment variable as a time.Duration (e.g.
+// "5s"), or a fallback if not set, empty, or invalid.
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+// getEnvFloat reads an environment variable as a float64, or a fallback if
+// not set, empty, or invalid.
+func getEnvFloat(key string, fallback float64) float64 {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fallback
+	}
+	return f
+}
+
 // loadEmbeddedWordlist reads the embedded wordlist.json file and returns the list of words.
 func loadEmbeddedWordlist() ([]string, error) {
 	b, err := embeddedFS.ReadFile("wordlist/wordlist.json")
@@ -106,8 +286,10 @@ func loadEmbeddedWordlist() ([]string, error) {
 	return words, nil
 }
 
-// newService creates a new Service instance with the provided word list
-func newService(words []string) (*Service, error) {
+// newService creates a new Service instance with the provided word list.
+// clampEpsilon controls how far outside +/-90/+/-180 degrees encodeFixPhrase
+// will clamp rather than reject, to absorb client-side rounding.
+func newService(words []string, clampEpsilon float64) (*Service, error) {
 	// Indices used by algorithm:
 	// 0..1999, 2000..5609, 5610..6609, 6610..7609 => need 7610 words.
 	// ignore longer wordlists, but error on shorter ones.
@@ -115,10 +297,93 @@ func newService(words []string) (*Service, error) {
 		return nil, fmt.Errorf("wordlist too short: got %d, need 7610", len(words))
 	}
 	m := make(map[string]int, len(words))
+	fuzzy := make(map[fuzzyKey][]string)
 	for i, w := range words {
-		m[strings.ToLower(w)] = i
+		lw := strings.ToLower(w)
+		m[lw] = i
+		if lw == "" {
+			continue
+		}
+		key := fuzzyKey{length: len(lw)}
+		fuzzy[key] = append(fuzzy[key], lw)
+	}
+	return &Service{words: words, wordToIndex: m, fuzzyIndex: fuzzy, clampEpsilon: clampEpsilon}, nil
+}
+
+// fuzzyMatch looks for a bounded typo correction for word: it only scores
+// candidates whose length is within one of word's own length (covering a
+// single dropped or inserted letter as well as same-length substitutions and
+// transpositions), and accepts the closest one if its Damerau-Levenshtein
+// distance is <= 2 and clearly better than the runner-up (by at least 1), so
+// ambiguous typos are rejected rather than guessed at.
+func (s *Service) fuzzyMatch(word string) (string, bool) {
+	var candidates []string
+	for _, l := range [3]int{len(word) - 1, len(word), len(word) + 1} {
+		candidates = append(candidates, s.fuzzyIndex[fuzzyKey{length: l}]...)
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	const maxDistance = 2
+	best, secondBest := maxDistance+1, maxDistance+1
+	bestWord := ""
+	for _, c := range candidates {
+		d := damerauLevenshtein(word, c)
+		if d < best {
+			secondBest = best
+			best, bestWord = d, c
+		} else if d < secondBest {
+			secondBest = d
+		}
+	}
+	if best > maxDistance || secondBest < best+1 {
+		return "", false
+	}
+	return bestWord, true
+}
+
+// damerauLevenshtein computes the optimal string alignment distance between
+// a and b, counting insertions, deletions, substitutions, and transpositions
+// of adjacent characters as single edits.
+func damerauLevenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+
+	d := make([][]int, la+1)
+	for i := range d {
+		d[i] = make([]int, lb+1)
+		d[i][0] = i
+	}
+	for j := 0; j <= lb; j++ {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= la; i++ {
+		for j := 1; j <= lb; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			del := d[i-1][j] + 1
+			ins := d[i][j-1] + 1
+			sub := d[i-1][j-1] + cost
+			best := del
+			if ins < best {
+				best = ins
+			}
+			if sub < best {
+				best = sub
+			}
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				if trans := d[i-2][j-2] + 1; trans < best {
+					best = trans
+				}
+			}
+			d[i][j] = best
+		}
 	}
-	return &Service{words: words, wordToIndex: m}, nil
+	return d[la][lb]
 }
 
 func (s *Service) word(index int) (string, error) {
@@ -132,12 +397,24 @@ func (s *Service) word(index int) (string, error) {
 // encodeFixPhrase takes latitude and longitude, 
 // validates them, 
 // and returns the resulting phrase and words.
-func encodeFixPhrase(svc *Service, latitude, longitude float64) (*EncodeResponse, error) {
+func encodeFixPhrase(ctx context.Context, svc *Service, latitude, longitude float64) (*EncodeResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, apierr.New(apierr.CodeRequestCanceled, err)
+	}
+	var clamped bool
 	if latitude > 90 || latitude < -90 {
-		return nil, fmt.Errorf("latitude out of range: %v", latitude)
+		if latitude > 90+svc.clampEpsilon || latitude < -90-svc.clampEpsilon {
+			return nil, apierr.New(apierr.CodeInvalidLat, fmt.Errorf("latitude out of range: %v", latitude))
+		}
+		latitude = math.Max(-90, math.Min(90, latitude))
+		clamped = true
 	}
 	if longitude > 180 || longitude < -180 {
-		return nil, fmt.Errorf("longitude out of range: %v", longitude)
+		if longitude > 180+svc.clampEpsilon || longitude < -180-svc.clampEpsilon {
+			return nil, apierr.New(apierr.CodeInvalidLon, fmt.Errorf("longitude out of range: %v", longitude))
+		}
+		longitude = math.Max(-180, math.Min(180, longitude))
+		clamped = true
 	}
 
 	latInt := int(math.Round(latitude*10000.0)) + 90*10000
@@ -158,19 +435,19 @@ func encodeFixPhrase(svc *Service, latitude, longitude float64) (*EncodeResponse
 
 	w0, err := svc.word(g0)
 	if err != nil {
-		return nil, fmt.Errorf("word0(%d): %w", g0, err)
+		return nil, apierr.New(apierr.CodeWordIndexOutOfRange, fmt.Errorf("word0(%d): %w", g0, err))
 	}
 	w1, err := svc.word(g1)
 	if err != nil {
-		return nil, fmt.Errorf("word1(%d): %w", g1, err)
+		return nil, apierr.New(apierr.CodeWordIndexOutOfRange, fmt.Errorf("word1(%d): %w", g1, err))
 	}
 	w2, err := svc.word(g2)
 	if err != nil {
-		return nil, fmt.Errorf("word2(%d): %w", g2, err)
+		return nil, apierr.New(apierr.CodeWordIndexOutOfRange, fmt.Errorf("word2(%d): %w", g2, err))
 	}
 	w3, err := svc.word(g3)
 	if err != nil {
-		return nil, fmt.Errorf("word3(%d): %w", g3, err)
+		return nil, apierr.New(apierr.CodeWordIndexOutOfRange, fmt.Errorf("word3(%d): %w", g3, err))
 	}
 
 	words := []string{w0, w1, w2, w3}
@@ -179,28 +456,43 @@ func encodeFixPhrase(svc *Service, latitude, longitude float64) (*EncodeResponse
 		Lon:     longitude,
 		Phrase:  strings.Join(words, " "),
 		Words:   words,
+		Clamped: clamped,
 	}, nil
 }
 
 // decodeFixPhrase takes a phrase, 
 // and returns the decoded coordinates along with the input words and a canonical phrase.
-func decodeFixPhrase(svc *Service, phrase string) (*DecodeResponse, error) {
+func decodeFixPhrase(ctx context.Context, svc *Service, phrase string) (*DecodeResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, apierr.New(apierr.CodeRequestCanceled, err)
+	}
 	phrase = strings.ToLower(strings.TrimSpace(phrase))
 	if phrase == "" {
-		return nil, errors.New("empty phrase (need at least 2 words)")
+		return nil, apierr.New(apierr.CodePhraseEmpty, errors.New("empty phrase (need at least 2 words)"))
 	}
 	parts := strings.Fields(phrase)
 	if len(parts) < 2 {
-		return nil, errors.New("not enough words (need at least 2)")
+		return nil, apierr.New(apierr.CodePhraseTooShort, errors.New("not enough words (need at least 2)"))
 	}
 
 	indexes := []int{-1, -1, -1, -1}
 	canonical := []string{"", "", "", ""}
+	var corrections []Correction
+	var unknownWords []string
 
 	for _, w := range parts {
+		if err := ctx.Err(); err != nil {
+			return nil, apierr.New(apierr.CodeRequestCanceled, err)
+		}
 		ix, ok := svc.wordToIndex[w]
 		if !ok {
-			continue
+			corrected, found := svc.fuzzyMatch(w)
+			if !found {
+				unknownWords = append(unknownWords, w)
+				continue
+			}
+			ix = svc.wordToIndex[corrected]
+			corrections = append(corrections, Correction{Original: w, Corrected: corrected})
 		}
 		switch {
 		case ix >= 0 && ix < 2000:
@@ -219,7 +511,10 @@ func decodeFixPhrase(svc *Service, phrase string) (*DecodeResponse, error) {
 	}
 
 	if indexes[0] == -1 || indexes[1] == -1 {
-		return nil, errors.New("supplied words input error?  This phrase is not decodable.")
+		if len(unknownWords) > 0 {
+			return nil, apierr.New(apierr.CodeUnknownWord, fmt.Errorf("no fuzzy match for word(s): %s", strings.Join(unknownWords, ", ")))
+		}
+		return nil, apierr.New(apierr.CodePhraseUndecodable, errors.New("supplied words input error?  This phrase is not decodable."))
 	}
 
 	divby := 10.0
@@ -265,6 +560,7 @@ func decodeFixPhrase(svc *Service, phrase string) (*DecodeResponse, error) {
 		Lat:             latitude,
 		Lon:             longitude,
 		AccuracyDegrees: accuracy,
+		Corrections:     corrections,
 	}, nil
 }
 
@@ -286,6 +582,28 @@ func readJSON(r *http.Request, dst any) error {
 	return dec.Decode(dst)
 }
 
+// writeAPIError writes err to w as a structured {"code":...,"message":...}
+// body. A plain (non-apierr) error is wrapped under CodeInvalidRequest so
+// callers always get a stable code to branch on.
+func writeAPIError(w http.ResponseWriter, status int, err error) {
+	apiErr, ok := apierr.As(err)
+	if !ok {
+		apiErr = apierr.New(apierr.CodeInvalidRequest, err)
+	}
+	writeJSON(w, status, apiErr)
+}
+
+// withTimeout bounds a request's context to timeout, so handlers (and the
+// algorithm code they call into) can detect a slow client or a stuck
+// operation and bail out instead of holding a goroutine open indefinitely.
+func withTimeout(timeout time.Duration, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		h(w, r.WithContext(ctx))
+	}
+}
+
 // ---------- main ----------
 func main() {
 	cfg := loadConfig()
@@ -294,10 +612,11 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	svc, err := newService(words)
+	svc, err := newService(words, cfg.ClampEpsilon)
 	if err != nil {
 		log.Fatal(err)
 	}
+	wordlistSize.Set(float64(len(svc.words)))
 
 	log.Printf("starting %s", cfg.ServerName)
 	log.Printf("repo: %s", cfg.RepoURL)
@@ -309,7 +628,7 @@ func main() {
 	// https://github.com/sparkes/fixphrase-service/tree/main?tab=readme-ov-file#get
 	// GET /encode?lat=..&lon=..
 	// POST /encode { "lat": .., "lon": .. }
-	mux.HandleFunc("/encode", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/encode", instrumentRoute("/encode", withTimeout(cfg.RequestTimeout, func(w http.ResponseWriter, r *http.Request) {
 		var lat, lon float64
 
 		switch r.Method {
@@ -318,86 +637,85 @@ func main() {
 			latS := q.Get("lat")
 			lonS := q.Get("lon")
 			if latS == "" || lonS == "" {
-				writeJSON(w, 400, map[string]any{
-					"error": "missing query params: lat and lon are required",
-					"usage": []string{
+				writeAPIError(w, 400, apierr.NewWithUsage(apierr.CodeInvalidRequest,
+					errors.New("missing query params: lat and lon are required"),
+					[]string{
 						"GET  /encode?lat=52.5902&lon=-2.13049",
 						`POST /encode {"lat":52.5902,"lon":-2.13049}`,
-					},
-				})
+					}))
 				return
 			}
 			var err error
 			lat, err = strconv.ParseFloat(latS, 64)
 			if err != nil {
-				writeJSON(w, 400, map[string]any{"error": "invalid lat", "detail": err.Error()})
+				writeAPIError(w, 400, apierr.New(apierr.CodeInvalidLat, err))
 				return
 			}
 			lon, err = strconv.ParseFloat(lonS, 64)
 			if err != nil {
-				writeJSON(w, 400, map[string]any{"error": "invalid lon", "detail": err.Error()})
+				writeAPIError(w, 400, apierr.New(apierr.CodeInvalidLon, err))
 				return
 			}
 
 		case http.MethodPost:
 			var req EncodeRequest
 			if err := readJSON(r, &req); err != nil {
-				writeJSON(w, 400, map[string]any{"error": "invalid json", "detail": err.Error()})
+				writeAPIError(w, 400, apierr.New(apierr.CodeInvalidJSON, err))
 				return
 			}
 			lat, lon = req.Lat, req.Lon
 
 		default:
 			w.Header().Set("Allow", "GET, POST")
-			writeJSON(w, 405, map[string]any{"error": "method not allowed"})
+			writeAPIError(w, 405, apierr.New(apierr.CodeMethodNotAllowed, nil))
 			return
 		}
 
-		res, err := encodeFixPhrase(svc, lat, lon)
+		res, err := encodeFixPhrase(r.Context(), svc, lat, lon)
 		if err != nil {
-			writeJSON(w, 400, map[string]any{"error": err.Error()})
+			writeAPIError(w, 400, err)
 			return
 		}
 		writeJSON(w, 200, res)
-	})
+	})))
 
 	// https://github.com/sparkes/fixphrase-service/tree/main?tab=readme-ov-file#get-1
 	// GET /decode?phrase=word1%20word2%20word3%20word4
 	// POST /decode { "phrase": "word1 word2 ..." }
-	mux.HandleFunc("/decode", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/decode", instrumentRoute("/decode", withTimeout(cfg.RequestTimeout, func(w http.ResponseWriter, r *http.Request) {
 		var phrase string
 
 		switch r.Method {
 		case http.MethodGet:
 			phrase = r.URL.Query().Get("phrase")
 			if strings.TrimSpace(phrase) == "" {
-				writeJSON(w, 400, map[string]any{
-					"error": "missing query param: phrase",
-					"usage": []string{
+				writeAPIError(w, 400, apierr.NewWithUsage(apierr.CodeInvalidRequest,
+					errors.New("missing query param: phrase"),
+					[]string{
 						"GET  /decode?phrase=abacus%20abdomen%20...",
 						`POST /decode {"phrase":"abacus abdomen ..."}`,
-					},
-				})
+					}))
 				return
 			}
 		case http.MethodPost:
 			var req DecodeRequest
 			if err := readJSON(r, &req); err != nil {
-				writeJSON(w, 400, map[string]any{"error": "invalid json", "detail": err.Error()})
+				writeAPIError(w, 400, apierr.New(apierr.CodeInvalidJSON, err))
 				return
 			}
 			phrase = req.Phrase
 		default:
 			w.Header().Set("Allow", "GET, POST")
-			writeJSON(w, 405, map[string]any{"error": "method not allowed"})
+			writeAPIError(w, 405, apierr.New(apierr.CodeMethodNotAllowed, nil))
 			return
 		}
 
-		res, err := decodeFixPhrase(svc, phrase)
+		res, err := decodeFixPhrase(r.Context(), svc, phrase)
 		if err != nil {
-			writeJSON(w, 400, map[string]any{"error": err.Error()})
+			writeAPIError(w, 400, err)
 			return
 		}
+		recordFuzzyCorrections(res.Corrections)
 
 		// Optional nicety: also show sorted input words.
 		sorted := append([]string{}, res.InputWords...)
@@ -407,9 +725,111 @@ func main() {
 			"result":           res,
 			"inputWordsSorted": sorted,
 		})
-	})
+	})))
+
+	// POST /encode/batch {"items":[{"lat":..,"lon":..}, ...]}
+	mux.HandleFunc("/encode/batch", instrumentRoute("/encode/batch", withTimeout(cfg.RequestTimeout, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			writeAPIError(w, 405, apierr.New(apierr.CodeMethodNotAllowed, nil))
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxBatchBodyBytes)
+		var req BatchEncodeRequest
+		if err := readJSON(r, &req); err != nil {
+			writeAPIError(w, 400, apierr.New(apierr.CodeInvalidJSON, err))
+			return
+		}
+		if len(req.Items) > cfg.MaxBatch {
+			writeAPIError(w, 400, apierr.New(apierr.CodeBatchTooLarge,
+				fmt.Errorf("batch too large: got %d items, max %d", len(req.Items), cfg.MaxBatch)))
+			return
+		}
 
-	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		items := make([]BatchEncodeItem, len(req.Items))
+		for i, it := range req.Items {
+			res, err := encodeFixPhrase(r.Context(), svc, it.Lat, it.Lon)
+			if err != nil {
+				apiErr, _ := apierr.As(err)
+				items[i] = BatchEncodeItem{Error: apiErr}
+				continue
+			}
+			items[i] = BatchEncodeItem{Result: res}
+		}
+		writeJSON(w, 200, map[string]any{"items": items})
+	})))
+
+	// POST /decode/batch {"items":[{"phrase":"..."}, ...]}
+	mux.HandleFunc("/decode/batch", instrumentRoute("/decode/batch", withTimeout(cfg.RequestTimeout, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			writeAPIError(w, 405, apierr.New(apierr.CodeMethodNotAllowed, nil))
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxBatchBodyBytes)
+		var req BatchDecodeRequest
+		if err := readJSON(r, &req); err != nil {
+			writeAPIError(w, 400, apierr.New(apierr.CodeInvalidJSON, err))
+			return
+		}
+		if len(req.Items) > cfg.MaxBatch {
+			writeAPIError(w, 400, apierr.New(apierr.CodeBatchTooLarge,
+				fmt.Errorf("batch too large: got %d items, max %d", len(req.Items), cfg.MaxBatch)))
+			return
+		}
+
+		items := make([]BatchDecodeItem, len(req.Items))
+		for i, it := range req.Items {
+			res, err := decodeFixPhrase(r.Context(), svc, it.Phrase)
+			if err != nil {
+				apiErr, _ := apierr.As(err)
+				items[i] = BatchDecodeItem{Error: apiErr}
+				continue
+			}
+			recordFuzzyCorrections(res.Corrections)
+			items[i] = BatchDecodeItem{Result: res}
+		}
+		writeJSON(w, 200, map[string]any{"items": items})
+	})))
+
+	// GET /neighbors?phrase=word1%20word2%20word3%20word4&radius=1
+	mux.HandleFunc("/neighbors", instrumentRoute("/neighbors", withTimeout(cfg.RequestTimeout, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			writeAPIError(w, 405, apierr.New(apierr.CodeMethodNotAllowed, nil))
+			return
+		}
+
+		q := r.URL.Query()
+		phrase := q.Get("phrase")
+		if strings.TrimSpace(phrase) == "" {
+			writeAPIError(w, 400, apierr.NewWithUsage(apierr.CodeInvalidRequest,
+				errors.New("missing query param: phrase"),
+				[]string{"GET /neighbors?phrase=abacus%20abdomen%20...&radius=1"}))
+			return
+		}
+
+		radius := 1.0
+		if radiusS := q.Get("radius"); radiusS != "" {
+			var err error
+			radius, err = strconv.ParseFloat(radiusS, 64)
+			if err != nil || radius <= 0 {
+				writeAPIError(w, 400, apierr.New(apierr.CodeInvalidRequest, fmt.Errorf("invalid radius: %q", radiusS)))
+				return
+			}
+		}
+
+		items, err := neighborPhrases(r.Context(), svc, phrase, radius)
+		if err != nil {
+			writeAPIError(w, 400, err)
+			return
+		}
+		writeJSON(w, 200, map[string]any{"phrase": phrase, "radius": radius, "neighbors": items})
+	})))
+
+	mux.HandleFunc("/healthz", instrumentRoute("/healthz", withTimeout(cfg.RequestTimeout, func(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, 200, map[string]any{
 			"ok":          true,
 			"service":     cfg.ServerName,
@@ -421,7 +841,9 @@ func main() {
 			"wordlistLen": len(svc.words),
 			"time":        time.Now().UTC().Format(time.RFC3339),
 		})
-	})
+	})))
+
+	mux.Handle("/metrics", promhttp.Handler())
 
 	addr := cfg.Addr
 
@@ -429,9 +851,11 @@ func main() {
 		Addr:              addr,
 		Handler:           mux,
 		ReadHeaderTimeout: 5 * time.Second,
+		WriteTimeout:      cfg.RequestTimeout + 5*time.Second,
+		IdleTimeout:       120 * time.Second,
 	}
 
-	// Start server
+	// Start HTTP server
 	go func() {
 		log.Printf("listening on %s (version=%s commit=%s)", addr, version, commit)
 		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -439,6 +863,21 @@ func main() {
 		}
 	}()
 
+	// Start gRPC server alongside the HTTP one.
+	grpcServer := grpc.NewServer()
+	fixphrasepb.RegisterFixPhraseServer(grpcServer, newFixPhraseGRPCServer(svc))
+
+	grpcLis, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		log.Fatalf("grpc listen: %v", err)
+	}
+	go func() {
+		log.Printf("grpc listening on %s", cfg.GRPCAddr)
+		if err := grpcServer.Serve(grpcLis); err != nil {
+			log.Fatalf("grpc serve: %v", err)
+		}
+	}()
+
 	// Graceful shutdown on SIGINT/SIGTERM
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
@@ -448,6 +887,21 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// GracefulStop waits for in-flight RPCs (including EncodeStream/
+	// DecodeStream) to finish on their own, so race it against the same
+	// deadline as the HTTP shutdown and force-stop if it overruns.
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		log.Printf("grpc graceful shutdown timed out, forcing stop")
+		grpcServer.Stop()
+	}
+
 	if err := server.Shutdown(ctx); err != nil {
 		log.Printf("graceful shutdown failed: %v", err)
 		_ = server.Close()
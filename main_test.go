@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sparkes/fixphrase-service/internal/apierr"
+)
+
+// newTestService builds a Service over a synthetic 7610-word list so
+// encode/decode/neighbor tests can exercise the real grid arithmetic without
+// the embedded wordlist.json asset.
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+	words := make([]string, 7610)
+	for i := range words {
+		words[i] = fmt.Sprintf("word%04d", i)
+	}
+	svc, err := newService(words, 0.001)
+	if err != nil {
+		t.Fatalf("newService: %v", err)
+	}
+	return svc
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"word1425", "word1425", 0},
+		{"word1425", "ord1425", 1},  // dropped leading letter
+		{"word1425", "wordd1425", 1}, // inserted letter
+		{"word1425", "owrd1425", 1}, // transposed leading letters
+		{"word1425", "wodr1425", 1}, // transposed interior letters
+		{"kitten", "sitting", 3},
+	}
+	for _, c := range cases {
+		if got := damerauLevenshtein(c.a, c.b); got != c.want {
+			t.Errorf("damerauLevenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+// TestFuzzyMatchLengthChangingTypos guards against the fuzzy bucket being
+// keyed so narrowly (length + first letter) that a dropped, inserted, or
+// first-letter typo can never find its correct-length bucket.
+func TestFuzzyMatchLengthChangingTypos(t *testing.T) {
+	fuzzy := make(map[fuzzyKey][]string)
+	for _, w := range []string{"word1425", "other", "zebra"} {
+		fuzzy[fuzzyKey{length: len(w)}] = append(fuzzy[fuzzyKey{length: len(w)}], w)
+	}
+	svc := &Service{fuzzyIndex: fuzzy}
+
+	cases := []struct {
+		name string
+		word string
+		want string
+	}{
+		{"dropped letter", "ord1425", "word1425"},
+		{"inserted letter", "wordd1425", "word1425"},
+		{"first letter typo", "aord1425", "word1425"},
+		{"interior transposition", "wodr1425", "word1425"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := svc.fuzzyMatch(c.word)
+			if !ok {
+				t.Fatalf("fuzzyMatch(%q) = not found, want %q", c.word, c.want)
+			}
+			if got != c.want {
+				t.Errorf("fuzzyMatch(%q) = %q, want %q", c.word, got, c.want)
+			}
+		})
+	}
+}
+
+func TestEncodeFixPhraseClampEpsilon(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+
+	cases := []struct {
+		name        string
+		lat, lon    float64
+		wantErr     bool
+		wantClamped bool
+	}{
+		{"within epsilon over", 90.0005, 0, false, true},
+		{"within epsilon under", -90.0005, 0, false, true},
+		{"exactly in range", 90, 0, false, false},
+		{"beyond epsilon", 90.01, 0, true, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := encodeFixPhrase(ctx, svc, c.lat, c.lon)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("encodeFixPhrase(%v, %v) = nil error, want error", c.lat, c.lon)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("encodeFixPhrase(%v, %v) = %v, want no error", c.lat, c.lon, err)
+			}
+			if res.Clamped != c.wantClamped {
+				t.Errorf("encodeFixPhrase(%v, %v).Clamped = %v, want %v", c.lat, c.lon, res.Clamped, c.wantClamped)
+			}
+		})
+	}
+}
+
+// TestDecodeFixPhraseUnknownWord guards against words with no exact or fuzzy
+// match being silently folded into the generic "phrase undecodable" error:
+// CodeUnknownWord exists specifically to name the offending word(s).
+func TestDecodeFixPhraseUnknownWord(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+
+	_, err := decodeFixPhrase(ctx, svc, "zzzzzzzzzzzzzzzzzzzz qqqqqqqqqqqqqqqqqqqq")
+	if err == nil {
+		t.Fatal("decodeFixPhrase(garbage) = nil error, want an error")
+	}
+	apiErr, ok := apierr.As(err)
+	if !ok {
+		t.Fatalf("decodeFixPhrase(garbage) error = %v, want an *apierr.Error", err)
+	}
+	if apiErr.Code != apierr.CodeUnknownWord {
+		t.Errorf("decodeFixPhrase(garbage) Code = %d, want %d (CodeUnknownWord)", apiErr.Code, apierr.CodeUnknownWord)
+	}
+}
+
+// TestNeighborPhrasesAntimeridianWraparound guards against neighbors near
+// the antimeridian clamping to lon=180 instead of wrapping to the true
+// adjacent phrase just past lon=-180.
+func TestNeighborPhrasesAntimeridianWraparound(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+
+	enc, err := encodeFixPhrase(ctx, svc, 10, 179.9999)
+	if err != nil {
+		t.Fatalf("encodeFixPhrase: %v", err)
+	}
+
+	items, err := neighborPhrases(ctx, svc, enc.Phrase, 1)
+	if err != nil {
+		t.Fatalf("neighborPhrases: %v", err)
+	}
+
+	byDirection := make(map[string]NeighborItem, len(items))
+	for _, it := range items {
+		byDirection[it.Direction] = it
+	}
+	for _, dir := range []string{"E", "NE", "SE"} {
+		it := byDirection[dir]
+		if it.Result == nil {
+			t.Fatalf("neighbor %s: no result (error=%+v)", dir, it.Error)
+		}
+		if it.Result.Lon > 0 {
+			t.Errorf("neighbor %s: Lon = %v, want a wrapped negative longitude near -180", dir, it.Result.Lon)
+		}
+	}
+}
+
+// TestNeighborPhrasesPoleClamping guards against neighbors north of the
+// north pole wrapping around to the southern hemisphere instead of clamping
+// at lat=90.
+func TestNeighborPhrasesPoleClamping(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+
+	enc, err := encodeFixPhrase(ctx, svc, 89.9999, 0)
+	if err != nil {
+		t.Fatalf("encodeFixPhrase: %v", err)
+	}
+
+	items, err := neighborPhrases(ctx, svc, enc.Phrase, 1)
+	if err != nil {
+		t.Fatalf("neighborPhrases: %v", err)
+	}
+
+	for _, it := range items {
+		if it.Direction != "N" {
+			continue
+		}
+		if it.Result == nil {
+			t.Fatalf("neighbor N: no result (error=%+v)", it.Error)
+		}
+		if it.Result.Lat != 90 {
+			t.Errorf("neighbor N: Lat = %v, want clamped to 90", it.Result.Lat)
+		}
+	}
+}
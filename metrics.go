@@ -0,0 +1,65 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "fixphrase_requests_total",
+		Help: "Total HTTP requests, by route, method, and response status.",
+	}, []string{"route", "method", "status"})
+
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "fixphrase_request_duration_seconds",
+		Help:    "HTTP request latency in seconds, by route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route"})
+
+	decodeFuzzyCorrectionsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "fixphrase_decode_fuzzy_corrections_total",
+		Help: "Total words accepted via fuzzy (Damerau-Levenshtein) matching during decode.",
+	})
+
+	wordlistSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "fixphrase_wordlist_size",
+		Help: "Number of words loaded into the service's wordlist.",
+	})
+)
+
+// statusRecorder wraps http.ResponseWriter to capture the status code written
+// by the wrapped handler, so the metrics middleware can label it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// instrumentRoute wraps h so every call records fixphrase_requests_total and
+// fixphrase_request_duration_seconds under the given route label.
+func instrumentRoute(route string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		h(rec, r)
+		requestDuration.WithLabelValues(route).Observe(time.Since(start).Seconds())
+		requestsTotal.WithLabelValues(route, r.Method, strconv.Itoa(rec.status)).Inc()
+	}
+}
+
+// recordFuzzyCorrections increments the fuzzy-correction counter for each
+// correction a decode produced.
+func recordFuzzyCorrections(corrections []Correction) {
+	if len(corrections) > 0 {
+		decodeFuzzyCorrectionsTotal.Add(float64(len(corrections)))
+	}
+}